@@ -38,12 +38,13 @@ func (h *loginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token := jwt.New(jwt.SigningMethodHS256)
-	token.Claims["exp"] = time.Now().Add(time.Hour * 72).Unix()
-	token.Claims["iss"] = "auth.service"
-	token.Claims["iat"] = time.Now().Unix()
-	token.Claims["email"] = user.Email
-	token.Claims["sub"] = user.Username
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp":   time.Now().Add(time.Hour * 72).Unix(),
+		"iss":   "auth.service",
+		"iat":   time.Now().Unix(),
+		"email": user.Email,
+		"sub":   user.Username,
+	})
 
 	tokenString, err := token.SignedString([]byte(h.secret))
 	if err != nil {